@@ -730,6 +730,11 @@ var (
 		Value:    "{}",
 		Category: flags.VMCategory,
 	}
+	VMProfileFlag = &cli.BoolFlag{
+		Name:     "vmprofile",
+		Usage:    "Keep per-transaction execution profiles of recently imported blocks for debug_vmProfile",
+		Category: flags.VMCategory,
+	}
 	// API options.
 	RPCGlobalGasCapFlag = &cli.Uint64Flag{
 		Name:     "rpc.gascap",
@@ -2232,6 +2237,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.IsSet(VMEnableDebugFlag.Name) {
 		cfg.EnablePreimageRecording = ctx.Bool(VMEnableDebugFlag.Name)
 	}
+	if ctx.IsSet(VMProfileFlag.Name) {
+		cfg.VMProfile = ctx.Bool(VMProfileFlag.Name)
+	}
 
 	if ctx.IsSet(VMOpcodeOptimizeFlag.Name) {
 		cfg.EnableOpcodeOptimizing = ctx.Bool(VMOpcodeOptimizeFlag.Name)
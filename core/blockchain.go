@@ -134,6 +134,7 @@ var (
 const (
 	bodyCacheLimit      = 256
 	blockCacheLimit     = 256
+	profileCacheLimit   = 32
 	receiptsCacheLimit  = 10000
 	sidecarsCacheLimit  = 1024
 	txLookupCacheLimit  = 1024
@@ -225,6 +226,10 @@ type BlockChainConfig struct {
 
 	// EnableBAL enables the block access list feature
 	EnableBAL bool
+
+	// VMProfile enables keeping the per-transaction execution profiles of
+	// recently processed blocks, served by the debug_vmProfile RPC.
+	VMProfile bool
 }
 
 // DefaultConfig returns the default config.
@@ -384,6 +389,7 @@ type BlockChain struct {
 	receiptsCache   *lru.Cache[common.Hash, []*types.Receipt] // Receipts cache with all fields derived
 	blockCache      *lru.Cache[common.Hash, *types.Block]
 	blockStatsCache *lru.Cache[common.Hash, *BlockStats]
+	profileCache    *lru.Cache[common.Hash, *BlockProfile]
 
 	txLookupLock  sync.RWMutex
 	txLookupCache *lru.Cache[common.Hash, txLookup]
@@ -484,6 +490,7 @@ func NewBlockChain(db ethdb.Database, genesis *Genesis, engine consensus.Engine,
 		sidecarsCache:   lru.NewCache[common.Hash, types.BlobSidecars](sidecarsCacheLimit),
 		blockCache:      lru.NewCache[common.Hash, *types.Block](blockCacheLimit),
 		blockStatsCache: lru.NewCache[common.Hash, *BlockStats](blockCacheLimit),
+		profileCache:    lru.NewCache[common.Hash, *BlockProfile](profileCacheLimit),
 		txLookupCache:   lru.NewCache[common.Hash, txLookup](txLookupCacheLimit),
 		futureBlocks:    lru.NewCache[common.Hash, *types.Block](maxFutureBlocks),
 		engine:          engine,
@@ -498,7 +505,9 @@ func NewBlockChain(db ethdb.Database, genesis *Genesis, engine consensus.Engine,
 	bc.statedb = state.NewDatabase(bc.triedb, nil)
 	bc.validator = NewBlockValidator(chainConfig, bc)
 	bc.prefetcher = NewStatePrefetcher(chainConfig, bc.hc)
-	bc.processor = NewStateProcessor(chainConfig, bc.hc)
+	processor := NewStateProcessor(chainConfig, bc.hc)
+	processor.profile = cfg.VMProfile
+	bc.processor = processor
 
 	genesisHeader := bc.GetHeaderByNumber(0)
 	if genesisHeader == nil {
@@ -1285,6 +1294,7 @@ func (bc *BlockChain) setHeadBeyondRoot(head uint64, time uint64, root common.Ha
 	bc.sidecarsCache.Purge()
 	bc.blockCache.Purge()
 	bc.blockStatsCache.Purge()
+	bc.profileCache.Purge()
 	bc.txLookupCache.Purge()
 	bc.futureBlocks.Purge()
 
@@ -2567,6 +2577,9 @@ func (bc *BlockChain) processBlock(parentRoot common.Hash, block *types.Block, s
 		return nil, err
 	}
 	vtime := time.Since(vstart)
+	if res.Profile != nil {
+		bc.profileCache.Add(block.Hash(), res.Profile)
+	}
 
 	// If witnesses was generated and stateless self-validation requested, do
 	// that now. Self validation should *never* run in production, it's more of
@@ -3412,6 +3425,14 @@ func (bc *BlockChain) GetBlockStats(hash common.Hash) *BlockStats {
 	return n
 }
 
+// GetBlockProfile returns the transaction execution profile of a recently
+// imported block. It returns nil if profiling is disabled, or if the block was
+// not processed by the block importer, e.g. it was sealed by the local miner.
+func (bc *BlockChain) GetBlockProfile(hash common.Hash) *BlockProfile {
+	profile, _ := bc.profileCache.Get(hash)
+	return profile
+}
+
 // PruneBlockHistory prune block history
 func (bc *BlockChain) PruneBlockHistory(blockHistory uint64) error {
 	// if the node try to keep entire chain blocks, just skip
@@ -4409,6 +4409,196 @@ func TestEIP7702(t *testing.T) {
 	}
 }
 
+// TestReceiptFields checks every field of the receipts returned by the chain for
+// one transaction of each type, to guard the receipt contract consumed by RPC.
+func TestReceiptFields(t *testing.T) {
+	var (
+		config  = *params.MergedTestChainConfig
+		signer  = types.LatestSigner(&config)
+		engine  = beacon.NewFaker()
+		key1, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		key2, _ = crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
+		addr1   = crypto.PubkeyToAddress(key1.PublicKey)
+		addr2   = crypto.PubkeyToAddress(key2.PublicKey)
+		aa      = common.HexToAddress("0x000000000000000000000000000000000000aaaa")
+		bb      = common.HexToAddress("0x000000000000000000000000000000000000bbbb")
+		topic   = common.HexToHash("0x01")
+		code    = program.New().Push(topic).Push(0).Push(0).Op(vm.LOG1).Bytes()
+		funds   = new(big.Int).Mul(common.Big1, big.NewInt(params.Ether))
+	)
+	gspec := &Genesis{
+		Config: &config,
+		Alloc: types.GenesisAlloc{
+			addr1: {Balance: funds},
+			aa:    {Code: code, Balance: big.NewInt(0)},
+			bb:    {Code: program.New().Push(0).Push(0).Op(vm.REVERT).Bytes(), Balance: big.NewInt(0)},
+		},
+	}
+	auth, _ := types.SignSetCode(key2, types.SetCodeAuthorization{
+		ChainID: *uint256.MustFromBig(gspec.Config.ChainID),
+		Address: aa,
+		Nonce:   0,
+	})
+	_, blocks, _ := GenerateChainWithGenesis(gspec, engine, 1, func(i int, b *BlockGen) {
+		b.AddTx(types.MustSignNewTx(key1, signer, &types.LegacyTx{
+			Nonce:    0,
+			To:       &aa,
+			Gas:      100000,
+			GasPrice: newGwei(5),
+		}))
+		b.AddTx(types.MustSignNewTx(key1, signer, &types.AccessListTx{
+			ChainID:    gspec.Config.ChainID,
+			Nonce:      1,
+			To:         &aa,
+			Gas:        100000,
+			GasPrice:   newGwei(5),
+			AccessList: types.AccessList{{Address: aa}},
+		}))
+		b.AddTx(types.MustSignNewTx(key1, signer, &types.DynamicFeeTx{
+			ChainID:   gspec.Config.ChainID,
+			Nonce:     2,
+			Gas:       200000,
+			GasFeeCap: newGwei(5),
+			GasTipCap: big.NewInt(2),
+			Data:      program.New().ReturnViaCodeCopy(code).Bytes(),
+		}))
+		tx, sidecar := makeMockTx(&config, signer, key1, 3, b.BaseFee().Uint64(), eip4844.CalcBlobFee(&config, b.HeadBlock()).Uint64(), true)
+		b.AddTx(tx)
+		b.AddBlobSidecar(&types.BlobSidecar{
+			BlobTxSidecar: *sidecar,
+			TxIndex:       3,
+			TxHash:        tx.Hash(),
+		})
+		b.AddTx(types.MustSignNewTx(key1, signer, &types.SetCodeTx{
+			ChainID:   uint256.MustFromBig(gspec.Config.ChainID),
+			Nonce:     4,
+			To:        addr2,
+			Gas:       100000,
+			GasFeeCap: uint256.MustFromBig(newGwei(5)),
+			GasTipCap: uint256.NewInt(2),
+			AuthList:  []types.SetCodeAuthorization{auth},
+		}))
+		b.AddTx(types.MustSignNewTx(key1, signer, &types.LegacyTx{
+			Nonce:    5,
+			To:       &bb,
+			Gas:      100000,
+			GasPrice: newGwei(5),
+		}))
+	})
+	chain, err := NewBlockChain(rawdb.NewMemoryDatabase(), gspec, engine, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+	if n, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+	var (
+		block    = blocks[0]
+		receipts = chain.GetReceiptsByHash(block.Hash())
+		txs      = block.Transactions()
+	)
+	if len(receipts) != len(txs) {
+		t.Fatalf("receipt count mismatch: have %d, want %d", len(receipts), len(txs))
+	}
+	// The logger code costs 759 gas: three pushes and a LOG1 without data.
+	tests := []struct {
+		gas      uint64         // Expected gas used
+		status   uint64         // Expected execution status
+		contract common.Address // Expected contract address, zero if not a creation
+		emitter  common.Address // Expected log emitter, zero if no logs are emitted
+	}{
+		{gas: 21000 + 759, status: types.ReceiptStatusSuccessful, emitter: aa},
+		{gas: 21000 + 2400 + 759, status: types.ReceiptStatusSuccessful, emitter: aa},
+		// Creation: 53000 + 5 zero and 15 non-zero calldata bytes + 1 initcode
+		// word, 24 for the initcode execution and 7 bytes of code deposit
+		{gas: 53000 + 5*4 + 15*16 + 2 + 24 + 7*200, status: types.ReceiptStatusSuccessful, contract: crypto.CreateAddress(addr1, 2)},
+		{gas: 21000, status: types.ReceiptStatusSuccessful},
+		{gas: 21000 + 25000 + 759, status: types.ReceiptStatusSuccessful, emitter: addr2},
+		{gas: 21000 + 6, status: types.ReceiptStatusFailed},
+	}
+	var (
+		cumulativeGas uint64
+		logIndex      uint
+	)
+	for i, test := range tests {
+		var (
+			tx      = txs[i]
+			receipt = receipts[i]
+		)
+		cumulativeGas += test.gas
+
+		if receipt.Type != tx.Type() {
+			t.Errorf("tx %d: type mismatch: have %d, want %d", i, receipt.Type, tx.Type())
+		}
+		if receipt.Status != test.status {
+			t.Errorf("tx %d: status mismatch: have %d, want %d", i, receipt.Status, test.status)
+		}
+		if receipt.GasUsed != test.gas {
+			t.Errorf("tx %d: gas used mismatch: have %d, want %d", i, receipt.GasUsed, test.gas)
+		}
+		if receipt.CumulativeGasUsed != cumulativeGas {
+			t.Errorf("tx %d: cumulative gas mismatch: have %d, want %d", i, receipt.CumulativeGasUsed, cumulativeGas)
+		}
+		if receipt.TxHash != tx.Hash() {
+			t.Errorf("tx %d: hash mismatch: have %x, want %x", i, receipt.TxHash, tx.Hash())
+		}
+		if receipt.ContractAddress != test.contract {
+			t.Errorf("tx %d: contract address mismatch: have %x, want %x", i, receipt.ContractAddress, test.contract)
+		}
+		if price := new(big.Int).Add(block.BaseFee(), tx.EffectiveGasTipValue(block.BaseFee())); receipt.EffectiveGasPrice.Cmp(price) != 0 {
+			t.Errorf("tx %d: effective gas price mismatch: have %v, want %v", i, receipt.EffectiveGasPrice, price)
+		}
+		if tx.Type() == types.BlobTxType {
+			if receipt.BlobGasUsed != tx.BlobGas() {
+				t.Errorf("tx %d: blob gas used mismatch: have %d, want %d", i, receipt.BlobGasUsed, tx.BlobGas())
+			}
+			if price := eip4844.CalcBlobFee(&config, block.Header()); receipt.BlobGasPrice == nil || receipt.BlobGasPrice.Cmp(price) != 0 {
+				t.Errorf("tx %d: blob gas price mismatch: have %v, want %v", i, receipt.BlobGasPrice, price)
+			}
+		} else if receipt.BlobGasUsed != 0 || receipt.BlobGasPrice != nil {
+			t.Errorf("tx %d: unexpected blob gas fields: used %d, price %v", i, receipt.BlobGasUsed, receipt.BlobGasPrice)
+		}
+		if receipt.BlockHash != block.Hash() {
+			t.Errorf("tx %d: block hash mismatch: have %x, want %x", i, receipt.BlockHash, block.Hash())
+		}
+		if receipt.BlockNumber.Cmp(block.Number()) != 0 {
+			t.Errorf("tx %d: block number mismatch: have %v, want %v", i, receipt.BlockNumber, block.Number())
+		}
+		if receipt.TransactionIndex != uint(i) {
+			t.Errorf("tx %d: transaction index mismatch: have %d, want %d", i, receipt.TransactionIndex, i)
+		}
+		if receipt.Bloom != types.CreateBloom(receipt) {
+			t.Errorf("tx %d: bloom mismatch", i)
+		}
+		// Check the logs, which are only emitted by calls into the logger code
+		if test.emitter == (common.Address{}) {
+			if len(receipt.Logs) != 0 {
+				t.Errorf("tx %d: unexpected logs: %d", i, len(receipt.Logs))
+			}
+			continue
+		}
+		if len(receipt.Logs) != 1 {
+			t.Errorf("tx %d: log count mismatch: have %d, want 1", i, len(receipt.Logs))
+			continue
+		}
+		l := receipt.Logs[0]
+		if l.Address != test.emitter || len(l.Topics) != 1 || l.Topics[0] != topic {
+			t.Errorf("tx %d: log content mismatch: address %x, topics %v", i, l.Address, l.Topics)
+		}
+		if l.Index != logIndex || l.TxIndex != uint(i) || l.TxHash != tx.Hash() {
+			t.Errorf("tx %d: log position mismatch: index %d, tx index %d, tx hash %x", i, l.Index, l.TxIndex, l.TxHash)
+		}
+		if l.BlockHash != block.Hash() || l.BlockNumber != block.NumberU64() {
+			t.Errorf("tx %d: log block mismatch: hash %x, number %d", i, l.BlockHash, l.BlockNumber)
+		}
+		if !receipt.Bloom.Test(test.emitter.Bytes()) || !receipt.Bloom.Test(topic.Bytes()) {
+			t.Errorf("tx %d: bloom doesn't contain the log", i)
+		}
+		logIndex++
+	}
+}
+
 // Tests the scenario that the synchronization target in snap sync has been changed
 // with a chain reorg at the tip. In this case the reorg'd segment should be unmarked
 // with canonical flags.
@@ -42,8 +42,9 @@ const largeTxGasLimit = 10000000 // 10M Gas, to measure the execution time of la
 //
 // StateProcessor implements Processor.
 type StateProcessor struct {
-	config *params.ChainConfig // Chain configuration options
-	chain  *HeaderChain        // Canonical header chain
+	config  *params.ChainConfig // Chain configuration options
+	chain   *HeaderChain        // Canonical header chain
+	profile bool                // Whether to collect per-transaction execution profiles
 }
 
 // NewStateProcessor initialises a new StateProcessor.
@@ -116,6 +117,11 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 	// usually do have two tx, one for validator set contract, another for system reward contract.
 	systemTxs := make([]*types.Transaction, 0, 2)
 
+	var profile *BlockProfile
+	if p.profile {
+		profile = newBlockProfile(blockNumber.Uint64(), blockHash, txNum)
+	}
+
 	for i, tx := range block.Transactions() {
 		if isPoSA {
 			if isSystemTx, err := posa.IsSystemTransaction(tx, block.Header()); err != nil {
@@ -141,11 +147,27 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 		}
 		statedb.SetTxContext(tx.Hash(), i)
 
+		var (
+			start                        time.Time
+			accountLoaded, storageLoaded int
+		)
+		if profile != nil {
+			start, accountLoaded, storageLoaded = time.Now(), statedb.AccountLoaded, statedb.StorageLoaded
+		}
 		receipt, err := ApplyTransactionWithEVM(msg, gp, statedb, blockNumber, blockHash, context.Time, tx, usedGas, evm, bloomProcessors)
 		if err != nil {
 			bloomProcessors.Close()
 			return nil, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
 		}
+		if profile != nil {
+			profile.add(TxProfile{
+				TxHash:        tx.Hash(),
+				GasUsed:       receipt.GasUsed,
+				Elapsed:       time.Since(start),
+				AccountLoaded: statedb.AccountLoaded - accountLoaded,
+				StorageLoaded: statedb.StorageLoaded - storageLoaded,
+			})
+		}
 		commonTxs = append(commonTxs, tx)
 		receipts = append(receipts, receipt)
 	}
@@ -187,6 +209,7 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 		Requests: requests,
 		Logs:     allLogs,
 		GasUsed:  *usedGas,
+		Profile:  profile,
 	}, nil
 }
 
@@ -0,0 +1,101 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TxProfile is the execution cost of a single transaction within a block.
+type TxProfile struct {
+	TxHash        common.Hash
+	GasUsed       uint64
+	Elapsed       time.Duration
+	AccountLoaded int // Number of accounts retrieved from the database
+	StorageLoaded int // Number of storage slots retrieved from the database
+}
+
+// BlockProfile collects the per-transaction execution profiles of a processed
+// block. System transactions applied during Finalize are not included.
+type BlockProfile struct {
+	Number uint64
+	Hash   common.Hash
+	Txs    []TxProfile
+}
+
+// newBlockProfile creates an empty profile for a block with txNum transactions.
+func newBlockProfile(number uint64, hash common.Hash, txNum int) *BlockProfile {
+	return &BlockProfile{
+		Number: number,
+		Hash:   hash,
+		Txs:    make([]TxProfile, 0, txNum),
+	}
+}
+
+// add records the execution of a transaction.
+func (p *BlockProfile) add(tx TxProfile) {
+	p.Txs = append(p.Txs, tx)
+}
+
+// GasUsed returns the total gas used by the profiled transactions.
+func (p *BlockProfile) GasUsed() uint64 {
+	var gas uint64
+	for _, tx := range p.Txs {
+		gas += tx.GasUsed
+	}
+	return gas
+}
+
+// Elapsed returns the total time spent executing the profiled transactions.
+func (p *BlockProfile) Elapsed() time.Duration {
+	var elapsed time.Duration
+	for _, tx := range p.Txs {
+		elapsed += tx.Elapsed
+	}
+	return elapsed
+}
+
+// AccountLoaded returns the number of accounts retrieved from the database by
+// the profiled transactions.
+func (p *BlockProfile) AccountLoaded() int {
+	var loaded int
+	for _, tx := range p.Txs {
+		loaded += tx.AccountLoaded
+	}
+	return loaded
+}
+
+// StorageLoaded returns the number of storage slots retrieved from the database
+// by the profiled transactions.
+func (p *BlockProfile) StorageLoaded() int {
+	var loaded int
+	for _, tx := range p.Txs {
+		loaded += tx.StorageLoaded
+	}
+	return loaded
+}
+
+// GasPerSecond returns the execution throughput of the profiled transactions.
+func (p *BlockProfile) GasPerSecond() float64 {
+	elapsed := p.Elapsed()
+	if elapsed == 0 {
+		return 0
+	}
+	return float64(p.GasUsed()) / elapsed.Seconds()
+}
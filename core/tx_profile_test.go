@@ -0,0 +1,148 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/program"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestBlockProfile(t *testing.T) {
+	profile := newBlockProfile(1, common.Hash{0x01}, 2)
+	if have := profile.GasPerSecond(); have != 0 {
+		t.Fatalf("empty profile throughput mismatch: have %v, want 0", have)
+	}
+	profile.add(TxProfile{TxHash: common.Hash{0x02}, GasUsed: 21000, Elapsed: 500 * time.Millisecond, AccountLoaded: 2})
+	profile.add(TxProfile{TxHash: common.Hash{0x03}, GasUsed: 79000, Elapsed: 500 * time.Millisecond, AccountLoaded: 1, StorageLoaded: 3})
+
+	if have, want := profile.GasUsed(), uint64(100000); have != want {
+		t.Fatalf("gas used mismatch: have %d, want %d", have, want)
+	}
+	if have, want := profile.Elapsed(), time.Second; have != want {
+		t.Fatalf("elapsed mismatch: have %v, want %v", have, want)
+	}
+	if have, want := profile.AccountLoaded(), 3; have != want {
+		t.Fatalf("accounts loaded mismatch: have %d, want %d", have, want)
+	}
+	if have, want := profile.StorageLoaded(), 3; have != want {
+		t.Fatalf("storage slots loaded mismatch: have %d, want %d", have, want)
+	}
+	if have, want := profile.GasPerSecond(), float64(100000); have != want {
+		t.Fatalf("throughput mismatch: have %v, want %v", have, want)
+	}
+}
+
+// Tests that the execution profiles of imported blocks are kept when enabled,
+// and that they match the receipts of the blocks.
+func TestBlockProfileImport(t *testing.T) {
+	var (
+		key, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr   = crypto.PubkeyToAddress(key.PublicKey)
+		aa     = common.HexToAddress("0x000000000000000000000000000000000000aaaa")
+		funds  = big.NewInt(params.Ether)
+		gspec  = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				addr: {Balance: funds},
+				// Reads two storage slots
+				aa: {Code: program.New().Push(0).Op(vm.SLOAD).Push(1).Op(vm.SLOAD, vm.POP, vm.POP).Bytes(), Balance: common.Big0},
+			},
+		}
+		signer = types.LatestSigner(gspec.Config)
+	)
+	_, blocks, receipts := GenerateChainWithGenesis(gspec, ethash.NewFaker(), 3, func(i int, b *BlockGen) {
+		// Pay a tip so that the coinbase isn't an empty account being deleted
+		// after every transaction.
+		price := new(big.Int).Add(b.BaseFee(), big.NewInt(params.GWei))
+		for j := 0; j <= i; j++ {
+			tx, _ := types.SignTx(types.NewTransaction(b.TxNonce(addr), aa, big.NewInt(1), 50000, price, nil), signer, key)
+			b.AddTx(tx)
+		}
+	})
+	for _, enabled := range []bool{false, true} {
+		config := DefaultConfig()
+		config.VMProfile = enabled
+
+		chain, err := NewBlockChain(rawdb.NewMemoryDatabase(), gspec, ethash.NewFaker(), config)
+		if err != nil {
+			t.Fatalf("failed to create tester chain: %v", err)
+		}
+		if _, err := chain.InsertChain(blocks); err != nil {
+			t.Fatalf("failed to insert chain: %v", err)
+		}
+		for i, block := range blocks {
+			profile := chain.GetBlockProfile(block.Hash())
+			if !enabled {
+				if profile != nil {
+					t.Fatalf("block %d: unexpected profile with profiling disabled", i)
+				}
+				continue
+			}
+			if profile == nil {
+				t.Fatalf("block %d: missing profile", i)
+			}
+			if profile.Number != block.NumberU64() || profile.Hash != block.Hash() {
+				t.Fatalf("block %d: profile header mismatch: have %d/%x, want %d/%x", i, profile.Number, profile.Hash, block.NumberU64(), block.Hash())
+			}
+			if len(profile.Txs) != len(receipts[i]) {
+				t.Fatalf("block %d: profiled tx count mismatch: have %d, want %d", i, len(profile.Txs), len(receipts[i]))
+			}
+			for j, receipt := range receipts[i] {
+				if profile.Txs[j].TxHash != receipt.TxHash {
+					t.Errorf("block %d tx %d: hash mismatch: have %x, want %x", i, j, profile.Txs[j].TxHash, receipt.TxHash)
+				}
+				if profile.Txs[j].GasUsed != receipt.GasUsed {
+					t.Errorf("block %d tx %d: gas used mismatch: have %d, want %d", i, j, profile.Txs[j].GasUsed, receipt.GasUsed)
+				}
+				// Only the first transaction of a block loads state from the
+				// database, the later ones hit the live state objects.
+				wantSlots := 0
+				if j == 0 {
+					wantSlots = 2
+					if profile.Txs[j].AccountLoaded == 0 {
+						t.Errorf("block %d tx %d: no accounts loaded", i, j)
+					}
+				} else if profile.Txs[j].AccountLoaded != 0 {
+					t.Errorf("block %d tx %d: accounts loaded mismatch: have %d, want 0", i, j, profile.Txs[j].AccountLoaded)
+				}
+				if profile.Txs[j].StorageLoaded != wantSlots {
+					t.Errorf("block %d tx %d: storage slots loaded mismatch: have %d, want %d", i, j, profile.Txs[j].StorageLoaded, wantSlots)
+				}
+			}
+			if have, want := profile.StorageLoaded(), 2; have != want {
+				t.Errorf("block %d: total storage slots loaded mismatch: have %d, want %d", i, have, want)
+			}
+			if have, want := profile.AccountLoaded(), profile.Txs[0].AccountLoaded; have != want {
+				t.Errorf("block %d: total accounts loaded mismatch: have %d, want %d", i, have, want)
+			}
+			if profile.GasUsed() != block.GasUsed() {
+				t.Errorf("block %d: total gas mismatch: have %d, want %d", i, profile.GasUsed(), block.GasUsed())
+			}
+		}
+		chain.Stop()
+	}
+}
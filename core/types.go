@@ -67,4 +67,5 @@ type ProcessResult struct {
 	Requests [][]byte
 	Logs     []*types.Log
 	GasUsed  uint64
+	Profile  *BlockProfile
 }
@@ -446,3 +446,68 @@ func (api *DebugAPI) GetTrieFlushInterval() (string, error) {
 	}
 	return api.eth.blockchain.GetTrieFlushInterval().String(), nil
 }
+
+// TxProfileResult is the execution profile of a single transaction.
+type TxProfileResult struct {
+	TxHash        common.Hash    `json:"txHash"`
+	GasUsed       hexutil.Uint64 `json:"gasUsed"`
+	Elapsed       string         `json:"elapsed"`
+	GasPerSecond  float64        `json:"gasPerSecond"`
+	AccountLoaded hexutil.Uint64 `json:"accountLoaded"`
+	StorageLoaded hexutil.Uint64 `json:"storageLoaded"`
+}
+
+// VMProfileResult is the result of a debug_vmProfile call.
+type VMProfileResult struct {
+	Number        hexutil.Uint64     `json:"number"`
+	Hash          common.Hash        `json:"hash"`
+	GasUsed       hexutil.Uint64     `json:"gasUsed"`
+	Elapsed       string             `json:"elapsed"`
+	GasPerSecond  float64            `json:"gasPerSecond"`
+	AccountLoaded hexutil.Uint64     `json:"accountLoaded"`
+	StorageLoaded hexutil.Uint64     `json:"storageLoaded"`
+	Txs           []*TxProfileResult `json:"txs"`
+}
+
+// VmProfile returns the per-transaction execution timing and gas throughput of
+// a recently imported block. Profiles are only kept when the node runs with
+// --vmprofile, and only for blocks processed by the block importer; blocks
+// sealed by the local miner are not profiled.
+func (api *DebugAPI) VmProfile(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*VMProfileResult, error) {
+	header, err := api.eth.APIBackend.HeaderByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, errors.New("block not found")
+	}
+	profile := api.eth.blockchain.GetBlockProfile(header.Hash())
+	if profile == nil {
+		return nil, fmt.Errorf("no execution profile for block %d", header.Number)
+	}
+	result := &VMProfileResult{
+		Number:        hexutil.Uint64(profile.Number),
+		Hash:          profile.Hash,
+		GasUsed:       hexutil.Uint64(profile.GasUsed()),
+		Elapsed:       profile.Elapsed().String(),
+		GasPerSecond:  profile.GasPerSecond(),
+		AccountLoaded: hexutil.Uint64(profile.AccountLoaded()),
+		StorageLoaded: hexutil.Uint64(profile.StorageLoaded()),
+		Txs:           make([]*TxProfileResult, 0, len(profile.Txs)),
+	}
+	for _, tx := range profile.Txs {
+		var gasPerSecond float64
+		if tx.Elapsed > 0 {
+			gasPerSecond = float64(tx.GasUsed) / tx.Elapsed.Seconds()
+		}
+		result.Txs = append(result.Txs, &TxProfileResult{
+			TxHash:        tx.TxHash,
+			GasUsed:       hexutil.Uint64(tx.GasUsed),
+			Elapsed:       tx.Elapsed.String(),
+			GasPerSecond:  gasPerSecond,
+			AccountLoaded: hexutil.Uint64(tx.AccountLoaded),
+			StorageLoaded: hexutil.Uint64(tx.StorageLoaded),
+		})
+	}
+	return result, nil
+}
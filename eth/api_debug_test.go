@@ -18,6 +18,7 @@ package eth
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
@@ -37,9 +38,11 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/triedb"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var dumper = spew.ConfigState{Indent: "    "}
@@ -335,3 +338,63 @@ func TestGetModifiedAccounts(t *testing.T) {
 		}
 	})
 }
+
+func TestVmProfile(t *testing.T) {
+	t.Parallel()
+
+	// Initialize test accounts
+	accounts := newAccounts(2)
+	genesis := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: types.GenesisAlloc{
+			accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		},
+	}
+	signer := types.HomesteadSigner{}
+	engine := ethash.NewFaker()
+	_, blocks, receipts := core.GenerateChainWithGenesis(genesis, engine, 2, func(i int, b *core.BlockGen) {
+		for j := 0; j <= i; j++ {
+			tx, _ := types.SignTx(types.NewTx(&types.LegacyTx{
+				Nonce:    b.TxNonce(accounts[0].addr),
+				To:       &accounts[1].addr,
+				Value:    big.NewInt(1000),
+				Gas:      params.TxGas,
+				GasPrice: b.BaseFee(),
+			}), signer, accounts[0].key)
+			b.AddTx(tx)
+		}
+	})
+	options := core.DefaultConfig()
+	options.VMProfile = true
+	blockChain, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), genesis, engine, options)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer blockChain.Stop()
+	if n, err := blockChain.InsertChain(blocks); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+
+	// Create a debug API instance.
+	eth := &Ethereum{blockchain: blockChain}
+	eth.APIBackend = &EthAPIBackend{eth: eth}
+	api := NewDebugAPI(eth)
+
+	for i, block := range blocks {
+		result, err := api.VmProfile(context.Background(), rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(block.NumberU64())))
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, block.Hash(), result.Hash)
+		assert.Equal(t, block.GasUsed(), uint64(result.GasUsed))
+		require.Len(t, result.Txs, len(receipts[i]))
+		for j, receipt := range receipts[i] {
+			assert.Equal(t, receipt.TxHash, result.Txs[j].TxHash)
+			assert.Equal(t, receipt.GasUsed, uint64(result.Txs[j].GasUsed))
+		}
+	}
+	// Unknown blocks and blocks without a profile must be rejected
+	_, err = api.VmProfile(context.Background(), rpc.BlockNumberOrHashWithHash(common.Hash{0x01}, false))
+	assert.Error(t, err)
+	_, err = api.VmProfile(context.Background(), rpc.BlockNumberOrHashWithNumber(0))
+	assert.Error(t, err)
+}
@@ -333,6 +333,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 			TrieCleanLimit:        config.TrieCleanCache,
 			NoPrefetch:            config.NoPrefetch,
 			EnableBAL:             config.EnableBAL,
+			VMProfile:             config.VMProfile,
 			TrieDirtyLimit:        config.TrieDirtyCache,
 			ArchiveMode:           config.NoPruning,
 			TrieTimeLimit:         config.TrieTimeout,
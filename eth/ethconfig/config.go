@@ -185,6 +185,9 @@ type Config struct {
 	VMTrace           string
 	VMTraceJsonConfig string
 
+	// Enables keeping execution profiles of recently imported blocks
+	VMProfile bool
+
 	// RPCGasCap is the global gas cap for eth-call variants.
 	RPCGasCap uint64
 
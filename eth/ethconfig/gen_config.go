@@ -67,6 +67,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		EnablePreimageRecording   bool
 		VMTrace                   string
 		VMTraceJsonConfig         string
+		VMProfile                 bool
 		RPCGasCap                 uint64
 		RPCEVMTimeout             time.Duration
 		RPCTxFeeCap               float64
@@ -137,6 +138,7 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.EnablePreimageRecording = c.EnablePreimageRecording
 	enc.VMTrace = c.VMTrace
 	enc.VMTraceJsonConfig = c.VMTraceJsonConfig
+	enc.VMProfile = c.VMProfile
 	enc.RPCGasCap = c.RPCGasCap
 	enc.RPCEVMTimeout = c.RPCEVMTimeout
 	enc.RPCTxFeeCap = c.RPCTxFeeCap
@@ -211,6 +213,7 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		EnablePreimageRecording   *bool
 		VMTrace                   *string
 		VMTraceJsonConfig         *string
+		VMProfile                 *bool
 		RPCGasCap                 *uint64
 		RPCEVMTimeout             *time.Duration
 		RPCTxFeeCap               *float64
@@ -382,6 +385,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.VMTraceJsonConfig != nil {
 		c.VMTraceJsonConfig = *dec.VMTraceJsonConfig
 	}
+	if dec.VMProfile != nil {
+		c.VMProfile = *dec.VMProfile
+	}
 	if dec.RPCGasCap != nil {
 		c.RPCGasCap = *dec.RPCGasCap
 	}
@@ -401,6 +401,12 @@ web3._extend({
 			call: 'debug_getBadBlocks',
 			params: 0,
 		}),
+		new web3._extend.Method({
+			name: 'vmProfile',
+			call: 'debug_vmProfile',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'storageRangeAt',
 			call: 'debug_storageRangeAt',
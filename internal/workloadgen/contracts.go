@@ -0,0 +1,154 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package workloadgen
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/program"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The contracts below are hand-assembled approximations of common mainnet
+// patterns. They don't implement the real token standards, only the storage
+// and log access shapes which matter for execution workloads.
+
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// Addresses at which the workload contracts are deployed in the genesis.
+var (
+	tokenAddr      = common.HexToAddress("0x000000000000000000000000000000000000c001")
+	nftAddr        = common.HexToAddress("0x000000000000000000000000000000000000c002")
+	proxyAddr      = common.HexToAddress("0x000000000000000000000000000000000000c003")
+	factoryAddr    = common.HexToAddress("0x000000000000000000000000000000000000c004")
+	reentrantAddr  = common.HexToAddress("0x000000000000000000000000000000000000c005")
+	precompileAddr = common.HexToAddress("0x000000000000000000000000000000000000c006")
+	revertAddr     = common.HexToAddress("0x000000000000000000000000000000000000c007")
+)
+
+// tokenCode is an ERC-20 style transfer(to, amount) taking the two words
+// unprefixed from calldata. Balances are keyed by address, underflows wrap.
+func tokenCode() []byte {
+	p := program.New()
+	p.Push(32).Op(vm.CALLDATALOAD)           // [amount]
+	p.Push(0).Op(vm.CALLDATALOAD)            // [amount, to]
+	p.Op(vm.DUP1, vm.SLOAD, vm.DUP3, vm.ADD) // [amount, to, balance(to)+amount]
+	p.Op(vm.SWAP1, vm.SSTORE)                // [amount]
+	p.Op(vm.CALLER, vm.SLOAD, vm.SUB)        // [balance(caller)-amount]
+	p.Op(vm.CALLER, vm.SSTORE)               // []
+
+	// Emit Transfer(caller, to, amount)
+	p.Push(32).Op(vm.CALLDATALOAD).Push(0).Op(vm.MSTORE)
+	p.Push(0).Op(vm.CALLDATALOAD)
+	p.Op(vm.CALLER)
+	p.Push(transferTopic)
+	p.Push(32).Push(0).Op(vm.LOG3)
+	return p.Op(vm.STOP).Bytes()
+}
+
+// nftCode is an ERC-721 style mint, assigning the next token id to the caller.
+func nftCode() []byte {
+	p := program.New()
+	p.Push(0).Op(vm.SLOAD).Push(1).Op(vm.ADD) // [id]
+	p.Op(vm.DUP1).Push(0).Op(vm.SSTORE)       // [id]
+	p.Op(vm.CALLER, vm.DUP2, vm.SSTORE)       // [id]
+
+	// Emit Transfer(0, caller, id)
+	p.Op(vm.CALLER).Push(0).Push(transferTopic)
+	p.Push(0).Push(0).Op(vm.LOG4)
+	return p.Op(vm.STOP).Bytes()
+}
+
+// proxyCode forwards the calldata to impl via DELEGATECALL.
+func proxyCode(impl common.Address) []byte {
+	p := program.New()
+	p.Op(vm.CALLDATASIZE).Push(0).Push(0).Op(vm.CALLDATACOPY)
+	p.Push(0).Push(0).Op(vm.CALLDATASIZE).Push(0)
+	p.Push(impl).Op(vm.GAS, vm.DELEGATECALL, vm.POP)
+	return p.Op(vm.STOP).Bytes()
+}
+
+// factoryInitcode is the initcode of the contracts deployed by the factory,
+// whose runtime code sets storage slot 0 to one.
+func factoryInitcode() []byte {
+	runtime := program.New().Sstore(0, 1).Op(vm.STOP).Bytes()
+	return program.New().ReturnViaCodeCopy(runtime).Bytes()
+}
+
+// factoryCode deploys a small storage-writing contract via CREATE2, using the
+// first calldata word as salt, and calls into it.
+func factoryCode() []byte {
+	initcode := factoryInitcode()
+
+	p := program.New()
+	p.Mstore(initcode, 0)
+	p.Push(0).Op(vm.CALLDATALOAD)             // salt
+	p.Push(len(initcode)).Push(0).Push(0)     // size, offset, value
+	p.Op(vm.CREATE2)                          // [addr]
+	p.Push(0).Push(0).Push(0).Push(0).Push(0) // out, in, value
+	p.Op(vm.DUP6, vm.GAS, vm.CALL, vm.POP, vm.POP)
+	return p.Op(vm.STOP).Bytes()
+}
+
+// reentrantCode calls itself recursively, the first calldata word being the
+// remaining depth. Every frame writes a storage slot keyed by its depth.
+func reentrantCode() []byte {
+	p := program.New()
+	p.Push(0).Op(vm.CALLDATALOAD) // [depth]
+	p.Op(vm.DUP1, vm.ISZERO)      // [depth, depth==0]
+
+	// Return at depth zero. The exit is placed after the body, so the jump
+	// target is pushed as a PUSH2 placeholder and patched once it is known.
+	p.Op(vm.PUSH2)
+	target := p.Size()
+	p.Append([]byte{0, 0}).Op(vm.JUMPI)
+
+	p.Op(vm.DUP1, vm.DUP1, vm.SSTORE)          // [depth]
+	p.Push(1).Op(vm.SWAP1, vm.SUB)             // [depth-1]
+	p.Push(0).Op(vm.MSTORE)                    // []
+	p.Push(0).Push(0).Push(32).Push(0).Push(0) // out, in, value
+	p.Op(vm.ADDRESS, vm.GAS, vm.CALL, vm.POP)
+
+	p, exit := p.Jumpdest()
+	code := p.Op(vm.STOP).Bytes()
+	binary.BigEndian.PutUint16(code[target:], uint16(exit))
+	return code
+}
+
+// precompileCode calls the precompile addressed by the first calldata word
+// with the rest of the calldata, and stores the first output word. The output
+// slot is kept apart from the input, so an empty output stores zero.
+func precompileCode() []byte {
+	p := program.New()
+	p.Push(32).Op(vm.CALLDATASIZE, vm.SUB)              // [n]
+	p.Op(vm.DUP1).Push(32).Push(32).Op(vm.CALLDATACOPY) // [n]
+	p.Push(32).Push(0).Op(vm.DUP3).Push(32)             // out, in
+	p.Push(0).Op(vm.CALLDATALOAD)                       // precompile
+	p.Op(vm.GAS, vm.STATICCALL, vm.POP, vm.POP)
+	p.Push(0).Op(vm.MLOAD).Push(0).Op(vm.SSTORE)
+	return p.Op(vm.STOP).Bytes()
+}
+
+// revertCode writes a storage slot and then reverts.
+func revertCode() []byte {
+	p := program.New()
+	p.Sstore(0, 1)
+	p.Push(0).Push(0)
+	return p.Op(vm.REVERT).Bytes()
+}
@@ -0,0 +1,277 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package workloadgen generates deterministic transaction workloads for chains
+// built with core.GenerateChainWithGenesis. Given the same configuration and
+// seed, the generated blocks are identical across runs and machines, which
+// makes it suitable for reproducible performance and regression tests.
+package workloadgen
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"math/big"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Mix is the relative weight of each kind of transaction in a workload. A
+// zero weight disables the kind.
+type Mix struct {
+	Transfer   int // Plain value transfers
+	Token      int // ERC-20 style balance transfers
+	NFT        int // ERC-721 style mints
+	Proxy      int // Token transfers via a DELEGATECALL proxy
+	Create2    int // CREATE2 deployments followed by a call
+	Reentrancy int // Recursive self calls
+	Precompile int // Calls into the hashing and ecrecover precompiles
+	Revert     int // Calls that write state and revert
+}
+
+// DefaultMix is a contract heavy mix loosely resembling mainnet traffic.
+var DefaultMix = Mix{
+	Transfer:   30,
+	Token:      25,
+	NFT:        10,
+	Proxy:      10,
+	Create2:    5,
+	Reentrancy: 5,
+	Precompile: 10,
+	Revert:     5,
+}
+
+// Config contains the parameters of a workload.
+type Config struct {
+	Seed        int64 // Seed of the workload, different seeds produce different chains
+	Accounts    int   // Number of funded sender accounts
+	TxsPerBlock int   // Number of transactions to add to every block
+	MaxDepth    int   // Maximum recursion depth of reentrancy calls
+	Mix         Mix   // Relative weight of each transaction kind
+}
+
+// DefaultConfig is the default workload configuration.
+var DefaultConfig = Config{
+	Seed:        1,
+	Accounts:    16,
+	TxsPerBlock: 32,
+	MaxDepth:    16,
+	Mix:         DefaultMix,
+}
+
+// txKind enumerates the transaction kinds of a Mix.
+type txKind int
+
+const (
+	kindTransfer txKind = iota
+	kindToken
+	kindNFT
+	kindProxy
+	kindCreate2
+	kindReentrancy
+	kindPrecompile
+	kindRevert
+)
+
+// weights returns the mix weights indexed by txKind.
+func (m Mix) weights() []int {
+	return []int{m.Transfer, m.Token, m.NFT, m.Proxy, m.Create2, m.Reentrancy, m.Precompile, m.Revert}
+}
+
+// Generator produces the genesis allocation and block contents of a workload.
+// It is not safe for concurrent use, and blocks must be generated in order for
+// the output to be deterministic.
+type Generator struct {
+	config *params.ChainConfig
+	cfg    Config
+	rng    *rand.Rand
+	keys   []*ecdsa.PrivateKey
+	addrs  []common.Address
+	total  int // Sum of the mix weights
+}
+
+// New creates a workload generator for the given chain config.
+func New(config *params.ChainConfig, cfg Config) *Generator {
+	g := &Generator{
+		config: config,
+		cfg:    cfg,
+		rng:    rand.New(rand.NewSource(cfg.Seed)),
+	}
+	for _, w := range cfg.Mix.weights() {
+		g.total += w
+	}
+	if g.total == 0 {
+		panic("workloadgen: empty transaction mix")
+	}
+	if cfg.Accounts == 0 {
+		panic("workloadgen: no sender accounts")
+	}
+	var seed [8]byte
+	binary.BigEndian.PutUint64(seed[:], uint64(cfg.Seed))
+	for i := 0; i < cfg.Accounts; i++ {
+		var index [8]byte
+		binary.BigEndian.PutUint64(index[:], uint64(i))
+		key, err := crypto.ToECDSA(crypto.Keccak256(seed[:], index[:]))
+		if err != nil {
+			panic(err)
+		}
+		g.keys = append(g.keys, key)
+		g.addrs = append(g.addrs, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	return g
+}
+
+// Accounts returns the addresses of the funded sender accounts.
+func (g *Generator) Accounts() []common.Address {
+	return g.addrs
+}
+
+// Alloc returns the genesis allocation funding the sender accounts and
+// deploying the workload contracts.
+func (g *Generator) Alloc() types.GenesisAlloc {
+	funds := new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(params.Ether))
+	alloc := types.GenesisAlloc{
+		tokenAddr:      {Code: tokenCode(), Balance: common.Big0},
+		nftAddr:        {Code: nftCode(), Balance: common.Big0},
+		proxyAddr:      {Code: proxyCode(tokenAddr), Balance: common.Big0},
+		factoryAddr:    {Code: factoryCode(), Balance: common.Big0},
+		reentrantAddr:  {Code: reentrantCode(), Balance: common.Big0},
+		precompileAddr: {Code: precompileCode(), Balance: common.Big0},
+		revertAddr:     {Code: revertCode(), Balance: common.Big0},
+	}
+	for _, addr := range g.addrs {
+		alloc[addr] = types.Account{Balance: funds}
+	}
+	return alloc
+}
+
+// Genesis returns a genesis block containing the workload allocation.
+func (g *Generator) Genesis() *core.Genesis {
+	return &core.Genesis{
+		Config:   g.config,
+		Alloc:    g.Alloc(),
+		GasLimit: 100_000_000,
+		BaseFee:  big.NewInt(params.InitialBaseFee),
+	}
+}
+
+// Generate adds the workload transactions to a block. It has the signature of
+// the block generation callback of core.GenerateChainWithGenesis. Transactions
+// which don't fit into the remaining block gas are skipped.
+func (g *Generator) Generate(i int, b *core.BlockGen) {
+	for n := 0; n < g.cfg.TxsPerBlock; n++ {
+		sender := g.rng.Intn(len(g.keys))
+		to, value, data, gas := g.nextCall()
+		if gas > b.Gas() {
+			continue
+		}
+		var (
+			nonce  = b.TxNonce(g.addrs[sender])
+			txdata types.TxData
+		)
+		if g.config.IsLondon(b.Number()) {
+			txdata = &types.DynamicFeeTx{
+				ChainID:   g.config.ChainID,
+				Nonce:     nonce,
+				To:        &to,
+				Value:     value,
+				Gas:       gas,
+				GasFeeCap: new(big.Int).Add(b.BaseFee(), big.NewInt(params.GWei)),
+				GasTipCap: big.NewInt(params.GWei),
+				Data:      data,
+			}
+		} else {
+			txdata = &types.LegacyTx{
+				Nonce:    nonce,
+				To:       &to,
+				Value:    value,
+				Gas:      gas,
+				GasPrice: big.NewInt(params.GWei),
+				Data:     data,
+			}
+		}
+		b.AddTx(types.MustSignNewTx(g.keys[sender], b.Signer(), txdata))
+	}
+}
+
+// nextCall picks the next transaction kind from the mix and assembles its
+// recipient, value, calldata and gas limit.
+func (g *Generator) nextCall() (common.Address, *big.Int, []byte, uint64) {
+	switch g.nextKind() {
+	case kindTransfer:
+		to := g.addrs[g.rng.Intn(len(g.addrs))]
+		if g.rng.Intn(2) == 0 {
+			to = common.BytesToAddress(g.randBytes(common.AddressLength))
+		}
+		return to, big.NewInt(g.rng.Int63n(params.Ether)), nil, params.TxGas
+
+	case kindToken:
+		return tokenAddr, common.Big0, g.tokenTransfer(), 150_000
+
+	case kindProxy:
+		return proxyAddr, common.Big0, g.tokenTransfer(), 150_000
+
+	case kindNFT:
+		return nftAddr, common.Big0, nil, 150_000
+
+	case kindCreate2:
+		return factoryAddr, common.Big0, g.randBytes(32), 300_000
+
+	case kindReentrancy:
+		depth := uint64(g.rng.Intn(g.cfg.MaxDepth + 1))
+		return reentrantAddr, common.Big0, common.LeftPadBytes(new(big.Int).SetUint64(depth).Bytes(), 32), 100_000 + depth*50_000
+
+	case kindPrecompile:
+		// ecrecover, sha256, ripemd160 and identity
+		precompile := common.BytesToAddress([]byte{byte(1 + g.rng.Intn(4))})
+		data := append(common.LeftPadBytes(precompile.Bytes(), 32), g.randBytes(128)...)
+		return precompileAddr, common.Big0, data, 150_000
+
+	default:
+		return revertAddr, common.Big0, nil, 100_000
+	}
+}
+
+// tokenTransfer assembles the calldata of a token transfer to a random sender
+// account.
+func (g *Generator) tokenTransfer() []byte {
+	to := common.LeftPadBytes(g.addrs[g.rng.Intn(len(g.addrs))].Bytes(), 32)
+	amount := common.LeftPadBytes(g.randBytes(4), 32)
+	return append(to, amount...)
+}
+
+// nextKind picks a transaction kind weighted by the configured mix.
+func (g *Generator) nextKind() txKind {
+	n := g.rng.Intn(g.total)
+	for kind, w := range g.cfg.Mix.weights() {
+		if n < w {
+			return txKind(kind)
+		}
+		n -= w
+	}
+	panic("unreachable")
+}
+
+// randBytes returns n bytes from the generator's random source.
+func (g *Generator) randBytes(n int) []byte {
+	b := make([]byte, n)
+	g.rng.Read(b)
+	return b
+}
@@ -0,0 +1,229 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package workloadgen
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func generate(t *testing.T, cfg Config, n int) ([]*types.Block, []types.Receipts) {
+	t.Helper()
+
+	g := New(params.TestChainConfig, cfg)
+	_, blocks, receipts := core.GenerateChainWithGenesis(g.Genesis(), ethash.NewFaker(), n, g.Generate)
+	return blocks, receipts
+}
+
+// importChain generates a workload chain and imports it into an archive node,
+// so the state after every block can be inspected.
+func importChain(t *testing.T, cfg Config, n int) (*core.BlockChain, []*types.Block) {
+	t.Helper()
+
+	g := New(params.TestChainConfig, cfg)
+	gspec := g.Genesis()
+	_, blocks, _ := core.GenerateChainWithGenesis(gspec, ethash.NewFaker(), n, g.Generate)
+
+	chain, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), gspec, ethash.NewFaker(), core.DefaultConfig().WithArchive(true))
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	t.Cleanup(chain.Stop)
+	if n, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+	return chain, blocks
+}
+
+func TestDeterministic(t *testing.T) {
+	cfg := DefaultConfig
+	blocksA, _ := generate(t, cfg, 4)
+	blocksB, _ := generate(t, cfg, 4)
+	for i := range blocksA {
+		if blocksA[i].Hash() != blocksB[i].Hash() {
+			t.Fatalf("block %d mismatch: %x != %x", i, blocksA[i].Hash(), blocksB[i].Hash())
+		}
+	}
+	cfg.Seed++
+	blocksC, _ := generate(t, cfg, 4)
+	if blocksA[len(blocksA)-1].Root() == blocksC[len(blocksC)-1].Root() {
+		t.Fatal("different seeds produced the same state")
+	}
+}
+
+func TestMix(t *testing.T) {
+	blocks, receipts := generate(t, DefaultConfig, 4)
+
+	var txs, failed, logs int
+	for i, block := range blocks {
+		txs += len(block.Transactions())
+		for _, receipt := range receipts[i] {
+			if receipt.Status == types.ReceiptStatusFailed {
+				failed++
+			}
+			logs += len(receipt.Logs)
+		}
+	}
+	if want := 4 * DefaultConfig.TxsPerBlock; txs != want {
+		t.Fatalf("transaction count mismatch: have %d, want %d", txs, want)
+	}
+	if failed == 0 {
+		t.Fatal("no reverted transactions in the workload")
+	}
+	if logs == 0 {
+		t.Fatal("no logs emitted by the workload")
+	}
+	// Only the revert contract is expected to fail
+	for i, block := range blocks {
+		for j, tx := range block.Transactions() {
+			if receipts[i][j].Status == types.ReceiptStatusFailed && *tx.To() != revertAddr {
+				t.Errorf("block %d tx %d to %x failed", i, j, *tx.To())
+			}
+		}
+	}
+}
+
+// Tests that the workload contracts leave the state changes they claim to, as
+// failures of their inner calls don't surface in the receipts.
+func TestContracts(t *testing.T) {
+	chain, blocks := importChain(t, DefaultConfig, 8)
+	statedb, err := chain.State()
+	if err != nil {
+		t.Fatalf("failed to open head state: %v", err)
+	}
+	var (
+		signer   = types.LatestSigner(params.TestChainConfig)
+		mints    int64
+		depth    int64
+		salts    [][]byte
+		balances = map[common.Address]map[common.Address]*big.Int{
+			tokenAddr: {},
+			proxyAddr: {},
+		}
+	)
+	// Replay the token transfers, which wrap around on underflow
+	transfer := func(contract, from common.Address, data []byte) {
+		to := common.BytesToAddress(data[:32])
+		amount := new(big.Int).SetBytes(data[32:64])
+		for _, addr := range []common.Address{to, from} {
+			if balances[contract][addr] == nil {
+				balances[contract][addr] = new(big.Int)
+			}
+		}
+		balances[contract][to].Add(balances[contract][to], amount)
+		balances[contract][from].Sub(balances[contract][from], amount)
+	}
+	for _, block := range blocks {
+		for _, tx := range block.Transactions() {
+			switch *tx.To() {
+			case nftAddr:
+				mints++
+			case reentrantAddr:
+				depth = max(depth, new(big.Int).SetBytes(tx.Data()).Int64())
+			case factoryAddr:
+				salts = append(salts, tx.Data())
+			case tokenAddr, proxyAddr:
+				from, err := types.Sender(signer, tx)
+				if err != nil {
+					t.Fatalf("failed to derive sender: %v", err)
+				}
+				transfer(*tx.To(), from, tx.Data())
+			}
+		}
+	}
+	if mints == 0 || depth == 0 || len(salts) == 0 {
+		t.Fatalf("workload too small: %d mints, depth %d, %d deployments", mints, depth, len(salts))
+	}
+	// The NFT counter is bumped by every mint
+	if have, want := statedb.GetState(nftAddr, common.Hash{}), common.BigToHash(big.NewInt(mints)); have != want {
+		t.Errorf("nft counter mismatch: have %x, want %x", have, want)
+	}
+	// Every reentrant frame writes its depth into the slot keyed by it
+	for i := int64(1); i <= depth; i++ {
+		slot := common.BigToHash(big.NewInt(i))
+		if have := statedb.GetState(reentrantAddr, slot); have != slot {
+			t.Errorf("reentrant slot %d mismatch: have %x, want %x", i, have, slot)
+		}
+	}
+	// The factory deploys a contract at the salted address and calls into it
+	inithash := crypto.Keccak256(factoryInitcode())
+	for _, salt := range salts {
+		addr := crypto.CreateAddress2(factoryAddr, common.BytesToHash(salt), inithash)
+		if len(statedb.GetCode(addr)) == 0 {
+			t.Errorf("no code deployed at %x", addr)
+		}
+		if have, want := statedb.GetState(addr, common.Hash{}), common.BigToHash(common.Big1); have != want {
+			t.Errorf("deployed contract %x slot mismatch: have %x, want %x", addr, have, want)
+		}
+	}
+	// Token balances are moved both directly and through the proxy
+	for contract, accounts := range balances {
+		if len(accounts) == 0 {
+			t.Fatalf("no token transfers via %x", contract)
+		}
+		for addr, balance := range accounts {
+			want := common.BytesToHash(new(big.Int).And(balance, new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)).Bytes())
+			if have := statedb.GetState(contract, common.BytesToHash(addr.Bytes())); have != want {
+				t.Errorf("token %x balance of %x mismatch: have %x, want %x", contract, addr, have, want)
+			}
+		}
+	}
+}
+
+// Tests that the precompile contract stores the first output word of every
+// precompile it calls, including empty outputs.
+func TestPrecompileOutput(t *testing.T) {
+	cfg := DefaultConfig
+	cfg.Mix = Mix{Precompile: 1}
+	cfg.TxsPerBlock = 1
+
+	chain, blocks := importChain(t, cfg, 32)
+
+	var empty int
+	for i, block := range blocks {
+		statedb, err := chain.StateAt(block.Root())
+		if err != nil {
+			t.Fatalf("block %d: failed to open state: %v", i, err)
+		}
+		data := block.Transactions()[0].Data()
+		precompile := common.BytesToAddress(data[:32])
+		output, err := vm.PrecompiledContractsHomestead[precompile].Run(data[32:])
+		if err != nil {
+			t.Fatalf("block %d: precompile %x failed: %v", i, precompile, err)
+		}
+		if len(output) == 0 {
+			empty++
+		}
+		var want common.Hash
+		copy(want[:], output)
+		if have := statedb.GetState(precompileAddr, common.Hash{}); have != want {
+			t.Errorf("block %d: precompile %x output mismatch: have %x, want %x", i, precompile, have, want)
+		}
+	}
+	if empty == 0 {
+		t.Fatal("no precompile call with empty output in the workload")
+	}
+}